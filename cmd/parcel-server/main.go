@@ -0,0 +1,42 @@
+// Command parcel-server exposes a ParcelStore over gRPC.
+package main
+
+import (
+	"database/sql"
+	"log"
+	"net"
+
+	"google.golang.org/grpc"
+
+	"go-db-sql-final/api/parcelpb"
+	"go-db-sql-final/grpcserver"
+	"go-db-sql-final/migrate"
+	"go-db-sql-final/store/sqlite"
+)
+
+const listenAddr = ":8082"
+
+func main() {
+	db, err := sql.Open("sqlite", "tracker.db")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	if err := migrate.Migrate(db); err != nil {
+		log.Fatal(err)
+	}
+
+	lis, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	grpcServer := grpc.NewServer()
+	parcelpb.RegisterParcelServiceServer(grpcServer, grpcserver.New(sqlite.NewStore(db)))
+
+	log.Printf("parcel-server listening on %s", listenAddr)
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,128 @@
+// Package grpcserver adapts a store.ParcelStore to the ParcelService gRPC
+// API defined in api/parcelpb.
+package grpcserver
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go-db-sql-final/api/parcelpb"
+	"go-db-sql-final/store"
+)
+
+// Server implements parcelpb.ParcelServiceServer on top of any
+// store.ParcelStore.
+type Server struct {
+	parcelpb.UnimplementedParcelServiceServer
+	store store.ParcelStore
+}
+
+// New returns a Server backed by s.
+func New(s store.ParcelStore) *Server {
+	return &Server{store: s}
+}
+
+// Add inserts a new parcel and returns its assigned number.
+func (srv *Server) Add(ctx context.Context, req *parcelpb.AddRequest) (*parcelpb.AddResponse, error) {
+	if req.Parcel == nil {
+		return nil, status.Error(codes.InvalidArgument, "parcel is required")
+	}
+
+	number, err := srv.store.Add(toDomainParcel(req.Parcel))
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &parcelpb.AddResponse{Number: int64(number)}, nil
+}
+
+// Get returns the parcel with the given number.
+func (srv *Server) Get(ctx context.Context, req *parcelpb.GetRequest) (*parcelpb.GetResponse, error) {
+	p, err := srv.store.Get(int(req.Number))
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &parcelpb.GetResponse{Parcel: toWireParcel(p)}, nil
+}
+
+// GetByClient streams every parcel belonging to the given client, one
+// message per parcel. The parcels are read from the store in full before the
+// first message is sent; the store's result set is not streamed.
+func (srv *Server) GetByClient(req *parcelpb.GetByClientRequest, stream parcelpb.ParcelService_GetByClientServer) error {
+	parcels, err := srv.store.GetByClient(int(req.Client))
+	if err != nil {
+		return toGRPCError(err)
+	}
+
+	for _, p := range parcels {
+		if err := stream.Context().Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		if err := stream.Send(toWireParcel(p)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetAddress updates the address of the parcel with the given number.
+func (srv *Server) SetAddress(ctx context.Context, req *parcelpb.SetAddressRequest) (*parcelpb.Empty, error) {
+	if err := srv.store.SetAddress(int(req.Number), req.Address); err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &parcelpb.Empty{}, nil
+}
+
+// SetStatus updates the status of the parcel with the given number.
+func (srv *Server) SetStatus(ctx context.Context, req *parcelpb.SetStatusRequest) (*parcelpb.Empty, error) {
+	if err := srv.store.SetStatus(int(req.Number), req.Status); err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &parcelpb.Empty{}, nil
+}
+
+// Delete removes the parcel with the given number.
+func (srv *Server) Delete(ctx context.Context, req *parcelpb.DeleteRequest) (*parcelpb.Empty, error) {
+	if err := srv.store.Delete(int(req.Number)); err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &parcelpb.Empty{}, nil
+}
+
+// toGRPCError translates a domain error into a gRPC status error.
+func toGRPCError(err error) error {
+	switch {
+	case errors.Is(err, store.ErrParcelNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, store.ErrInvalidStatusTransition), errors.Is(err, store.ErrAddressImmutable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func toDomainParcel(p *parcelpb.Parcel) store.Parcel {
+	if p == nil {
+		return store.Parcel{}
+	}
+	return store.Parcel{
+		Number:    int(p.Number),
+		Client:    int(p.Client),
+		Status:    p.Status,
+		Address:   p.Address,
+		CreatedAt: p.CreatedAt,
+	}
+}
+
+func toWireParcel(p store.Parcel) *parcelpb.Parcel {
+	return &parcelpb.Parcel{
+		Number:    int64(p.Number),
+		Client:    int64(p.Client),
+		Status:    p.Status,
+		Address:   p.Address,
+		CreatedAt: p.CreatedAt,
+	}
+}
@@ -0,0 +1,136 @@
+package grpcserver_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"go-db-sql-final/api/parcelpb"
+	"go-db-sql-final/grpcserver"
+	"go-db-sql-final/store"
+	"go-db-sql-final/store/memory"
+)
+
+const bufSize = 1024 * 1024
+
+// dial starts a ParcelService backed by an in-memory store on a bufconn
+// listener and returns a client connected to it. Shutting down the server
+// and closing the connection are registered with t.Cleanup.
+func dial(t *testing.T) parcelpb.ParcelServiceClient {
+	t.Helper()
+
+	lis := bufconn.Listen(bufSize)
+	grpcServer := grpc.NewServer()
+	parcelpb.RegisterParcelServiceServer(grpcServer, grpcserver.New(memory.NewStore()))
+
+	go func() {
+		_ = grpcServer.Serve(lis)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock(),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return parcelpb.NewParcelServiceClient(conn)
+}
+
+func TestAddRejectsNilParcel(t *testing.T) {
+	client := dial(t)
+
+	_, err := client.Add(context.Background(), &parcelpb.AddRequest{})
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAddGetDelete(t *testing.T) {
+	client := dial(t)
+	ctx := context.Background()
+
+	addResp, err := client.Add(ctx, &parcelpb.AddRequest{Parcel: &parcelpb.Parcel{
+		Client:    1000,
+		Status:    store.ParcelStatusRegistered,
+		Address:   "test",
+		CreatedAt: time.Now().UTC().Format(time.RFC3339),
+	}})
+	require.NoError(t, err)
+
+	getResp, err := client.Get(ctx, &parcelpb.GetRequest{Number: addResp.Number})
+	require.NoError(t, err)
+	require.Equal(t, "test", getResp.Parcel.Address)
+
+	_, err = client.Delete(ctx, &parcelpb.DeleteRequest{Number: addResp.Number})
+	require.NoError(t, err)
+
+	_, err = client.Get(ctx, &parcelpb.GetRequest{Number: addResp.Number})
+	require.Error(t, err)
+}
+
+func TestGetByClientStream(t *testing.T) {
+	client := dial(t)
+	ctx := context.Background()
+
+	const clientID = 42
+	for i := 0; i < 3; i++ {
+		_, err := client.Add(ctx, &parcelpb.AddRequest{Parcel: &parcelpb.Parcel{
+			Client:    clientID,
+			Status:    store.ParcelStatusRegistered,
+			Address:   "test",
+			CreatedAt: time.Now().UTC().Format(time.RFC3339),
+		}})
+		require.NoError(t, err)
+	}
+
+	stream, err := client.GetByClient(ctx, &parcelpb.GetByClientRequest{Client: clientID})
+	require.NoError(t, err)
+
+	var got int
+	for {
+		_, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		got++
+	}
+	require.Equal(t, 3, got)
+}
+
+func TestGetByClientStreamCancellation(t *testing.T) {
+	client := dial(t)
+
+	const clientID = 7
+	for i := 0; i < 5; i++ {
+		_, err := client.Add(context.Background(), &parcelpb.AddRequest{Parcel: &parcelpb.Parcel{
+			Client:    clientID,
+			Status:    store.ParcelStatusRegistered,
+			Address:   "test",
+			CreatedAt: time.Now().UTC().Format(time.RFC3339),
+		}})
+		require.NoError(t, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := client.GetByClient(ctx, &parcelpb.GetByClientRequest{Client: clientID})
+	require.NoError(t, err)
+
+	cancel()
+
+	_, err = stream.Recv()
+	require.Error(t, err)
+}
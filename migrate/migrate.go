@@ -0,0 +1,206 @@
+// Package migrate tracks and applies versioned schema changes to the
+// parcel database. Migrations live under migrations/ as ordered
+// NNNN_name.up.sql / NNNN_name.down.sql pairs and are embedded into the
+// binary so no extra files need to ship alongside it.
+package migrate
+
+import (
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//go:embed migrations/*.sql
+var migrationsFS embed.FS
+
+// migration is a single versioned schema change.
+type migration struct {
+	version int
+	name    string
+	up      string
+	down    string
+}
+
+// loadMigrations reads every NNNN_name.up.sql / NNNN_name.down.sql pair from
+// migrationsFS, ordered by version.
+func loadMigrations() ([]migration, error) {
+	entries, err := fs.ReadDir(migrationsFS, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("read migrations dir: %w", err)
+	}
+
+	byVersion := make(map[int]*migration)
+	for _, entry := range entries {
+		name := entry.Name()
+
+		var kind string
+		switch {
+		case strings.HasSuffix(name, ".up.sql"):
+			kind = "up"
+		case strings.HasSuffix(name, ".down.sql"):
+			kind = "down"
+		default:
+			continue
+		}
+
+		base := strings.TrimSuffix(name, "."+kind+".sql")
+		parts := strings.SplitN(base, "_", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid migration file name %q", name)
+		}
+
+		version, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version in %q: %w", name, err)
+		}
+
+		content, err := migrationsFS.ReadFile("migrations/" + name)
+		if err != nil {
+			return nil, fmt.Errorf("read %q: %w", name, err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &migration{version: version, name: parts[1]}
+			byVersion[version] = m
+		}
+		if kind == "up" {
+			m.up = string(content)
+		} else {
+			m.down = string(content)
+		}
+	}
+
+	migrations := make([]migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].version < migrations[j].version
+	})
+
+	return migrations, nil
+}
+
+// ensureMigrationsTable creates the schema_migrations bookkeeping table if
+// it does not already exist.
+func ensureMigrationsTable(db *sql.DB) error {
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		applied_at TEXT
+	)`)
+	return err
+}
+
+// appliedVersions returns the set of migration versions already applied to
+// db.
+func appliedVersions(db *sql.DB) (map[int]bool, error) {
+	rows, err := db.Query("SELECT version FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[int]bool)
+	for rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			return nil, err
+		}
+		applied[version] = true
+	}
+
+	return applied, rows.Err()
+}
+
+// Migrate applies every migration that has not yet been applied to db, in
+// version order.
+func Migrate(db *sql.DB) error {
+	return MigrateTo(db, -1)
+}
+
+// MigrateTo migrates db to exactly the given version, applying up
+// migrations if the current version is lower, or down migrations if it is
+// higher. Passing a negative version migrates to HEAD (the newest known
+// migration).
+func MigrateTo(db *sql.DB, version int) error {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+	if version < 0 && len(migrations) > 0 {
+		version = migrations[len(migrations)-1].version
+	}
+
+	if err := ensureMigrationsTable(db); err != nil {
+		return fmt.Errorf("ensure schema_migrations table: %w", err)
+	}
+
+	applied, err := appliedVersions(db)
+	if err != nil {
+		return fmt.Errorf("read applied migrations: %w", err)
+	}
+
+	for _, m := range migrations {
+		if m.version > version || applied[m.version] {
+			continue
+		}
+		if err := applyUp(db, m); err != nil {
+			return fmt.Errorf("apply migration %04d_%s: %w", m.version, m.name, err)
+		}
+	}
+
+	for i := len(migrations) - 1; i >= 0; i-- {
+		m := migrations[i]
+		if m.version <= version || !applied[m.version] {
+			continue
+		}
+		if err := applyDown(db, m); err != nil {
+			return fmt.Errorf("revert migration %04d_%s: %w", m.version, m.name, err)
+		}
+	}
+
+	return nil
+}
+
+func applyUp(db *sql.DB, m migration) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(m.up); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(
+		"INSERT INTO schema_migrations (version, applied_at) VALUES (?, ?)",
+		m.version, time.Now().UTC().Format(time.RFC3339),
+	); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func applyDown(db *sql.DB, m migration) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(m.down); err != nil {
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM schema_migrations WHERE version = ?", m.version); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
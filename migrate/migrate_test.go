@@ -0,0 +1,49 @@
+package migrate_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	// the sqlite driver registers itself under the "sqlite" name
+	_ "modernc.org/sqlite"
+
+	"go-db-sql-final/migrate"
+)
+
+// TestMigrateRoundtrip applies every up migration, inserts sample data,
+// reverts every down migration, and re-applies them, asserting that the
+// parcel table is dropped, comes back empty, and has the updated_at column
+// again.
+func TestMigrateRoundtrip(t *testing.T) {
+	db, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, migrate.Migrate(db))
+
+	_, err = db.Exec(
+		"INSERT INTO parcel (client, status, address, created_at) VALUES (1000, 'registered', 'test', '2024-01-01T00:00:00Z')",
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, migrate.MigrateTo(db, 0))
+
+	var tableCount int
+	err = db.QueryRow("SELECT count(*) FROM sqlite_master WHERE type = 'table' AND name = 'parcel'").Scan(&tableCount)
+	require.NoError(t, err)
+	require.Equal(t, 0, tableCount)
+
+	require.NoError(t, migrate.Migrate(db))
+
+	var rowCount int
+	err = db.QueryRow("SELECT count(*) FROM parcel").Scan(&rowCount)
+	require.NoError(t, err)
+	require.Equal(t, 0, rowCount) // re-creating the table starts empty again
+
+	_, err = db.Exec(
+		"INSERT INTO parcel (client, status, address, created_at, updated_at) VALUES (1000, 'registered', 'test', '2024-01-01T00:00:00Z', '2024-01-01T00:00:00Z')",
+	)
+	require.NoError(t, err) // the 0002 migration's updated_at column is back
+}
@@ -0,0 +1,99 @@
+package store
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// CollectorPolicy controls what the Collector does with stale parcels.
+type CollectorPolicy int
+
+const (
+	// CollectorPolicyExpire moves stale parcels to ParcelStatusExpired,
+	// keeping the record around as a terminal state.
+	CollectorPolicyExpire CollectorPolicy = iota
+	// CollectorPolicyDelete removes stale parcels outright.
+	CollectorPolicyDelete
+)
+
+// CollectorOption configures a Collector at construction time.
+type CollectorOption func(*Collector)
+
+// WithCollectorPolicy sets the policy applied to stale parcels. The default
+// is CollectorPolicyExpire.
+func WithCollectorPolicy(policy CollectorPolicy) CollectorOption {
+	return func(c *Collector) { c.policy = policy }
+}
+
+// Collector periodically reaps parcels that have sat in
+// ParcelStatusRegistered for longer than ttl, to keep the parcel table from
+// growing unbounded.
+type Collector struct {
+	store    ParcelStore
+	interval time.Duration
+	ttl      time.Duration
+	logger   *slog.Logger
+	policy   CollectorPolicy
+}
+
+// NewCollector returns a Collector that reaps stale parcels from store every
+// interval, using ttl to decide what counts as stale.
+func NewCollector(store ParcelStore, interval time.Duration, ttl time.Duration, logger *slog.Logger, opts ...CollectorOption) *Collector {
+	c := &Collector{
+		store:    store,
+		interval: interval,
+		ttl:      ttl,
+		logger:   logger,
+		policy:   CollectorPolicyExpire,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Run ticks every interval until ctx is done, reaping stale parcels on each
+// tick.
+func (c *Collector) Run(ctx context.Context) error {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := c.collectOnce(); err != nil {
+				c.logger.Error("collect stale parcels", "error", err)
+			}
+		}
+	}
+}
+
+// collectOnce reaps every parcel that has been ParcelStatusRegistered for
+// longer than c.ttl, applying c.policy to each.
+func (c *Collector) collectOnce() error {
+	stale, err := c.store.GetExpired(time.Now().Add(-c.ttl), ParcelStatusRegistered)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range stale {
+		var err error
+		switch c.policy {
+		case CollectorPolicyDelete:
+			err = c.store.Delete(p.Number)
+		default:
+			err = c.store.SetStatus(p.Number, ParcelStatusExpired)
+		}
+		if err != nil {
+			c.logger.Error("reap stale parcel", "number", p.Number, "error", err)
+			continue
+		}
+		c.logger.Info("reaped stale parcel", "number", p.Number, "policy", c.policy)
+	}
+
+	return nil
+}
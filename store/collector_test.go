@@ -0,0 +1,87 @@
+package store_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go-db-sql-final/store"
+	"go-db-sql-final/store/memory"
+)
+
+func TestCollector(t *testing.T) {
+	s := memory.NewStore()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	staleNumber, err := s.Add(store.Parcel{
+		Client:    1,
+		Status:    store.ParcelStatusRegistered,
+		Address:   "stale",
+		CreatedAt: time.Now().Add(-time.Hour).UTC().Format(time.RFC3339),
+	})
+	require.NoError(t, err)
+
+	freshNumber, err := s.Add(store.Parcel{
+		Client:    2,
+		Status:    store.ParcelStatusRegistered,
+		Address:   "fresh",
+		CreatedAt: time.Now().UTC().Format(time.RFC3339),
+	})
+	require.NoError(t, err)
+
+	collector := store.NewCollector(s, 5*time.Millisecond, time.Minute, logger)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+
+	err = collector.Run(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	stale, err := s.Get(staleNumber)
+	require.NoError(t, err)
+	require.Equal(t, store.ParcelStatusExpired, stale.Status)
+
+	fresh, err := s.Get(freshNumber)
+	require.NoError(t, err)
+	require.Equal(t, store.ParcelStatusRegistered, fresh.Status)
+}
+
+func TestCollectorDeletePolicy(t *testing.T) {
+	s := memory.NewStore()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	staleNumber, err := s.Add(store.Parcel{
+		Client:    1,
+		Status:    store.ParcelStatusRegistered,
+		Address:   "stale",
+		CreatedAt: time.Now().Add(-time.Hour).UTC().Format(time.RFC3339),
+	})
+	require.NoError(t, err)
+
+	collector := store.NewCollector(s, 5*time.Millisecond, time.Minute, logger, store.WithCollectorPolicy(store.CollectorPolicyDelete))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+
+	err = collector.Run(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_, err = s.Get(staleNumber)
+	require.Error(t, err)
+}
+
+func TestCollectorStopsOnContextCancel(t *testing.T) {
+	s := memory.NewStore()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	collector := store.NewCollector(s, time.Millisecond, time.Minute, logger)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := collector.Run(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,17 @@
+package store
+
+import "errors"
+
+// Sentinel errors returned by ParcelStore implementations.
+var (
+	// ErrParcelNotFound is returned when no parcel exists with the given
+	// number.
+	ErrParcelNotFound = errors.New("parcel not found")
+	// ErrInvalidStatusTransition is returned when a status change (or a
+	// Delete, which requires ParcelStatusRegistered) is not allowed from
+	// the parcel's current status.
+	ErrInvalidStatusTransition = errors.New("invalid parcel status transition")
+	// ErrAddressImmutable is returned by SetAddress once a parcel has
+	// left ParcelStatusRegistered.
+	ErrAddressImmutable = errors.New("parcel address can only be changed while registered")
+)
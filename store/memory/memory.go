@@ -0,0 +1,152 @@
+// Package memory is an in-memory implementation of store.ParcelStore,
+// intended for fast unit tests and local development.
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"go-db-sql-final/store"
+)
+
+// Store is an in-memory store.ParcelStore backed by a map guarded by an
+// RWMutex. It is safe for concurrent use.
+type Store struct {
+	mu      sync.RWMutex
+	parcels map[int]store.Parcel
+	nextID  int
+}
+
+// NewStore returns an empty Store.
+func NewStore() *Store {
+	return &Store{
+		parcels: make(map[int]store.Parcel),
+	}
+}
+
+// Add inserts a new parcel and returns its assigned number.
+func (s *Store) Add(p store.Parcel) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	p.Number = s.nextID
+	s.parcels[p.Number] = p
+
+	return p.Number, nil
+}
+
+// Get returns the parcel with the given number.
+func (s *Store) Get(number int) (store.Parcel, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	p, ok := s.parcels[number]
+	if !ok {
+		return store.Parcel{}, store.ErrParcelNotFound
+	}
+
+	return p, nil
+}
+
+// GetByClient returns every parcel belonging to the given client.
+func (s *Store) GetByClient(client int) ([]store.Parcel, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var parcels []store.Parcel
+	for _, p := range s.parcels {
+		if p.Client == client {
+			parcels = append(parcels, p)
+		}
+	}
+
+	return parcels, nil
+}
+
+// SetAddress updates the address of the parcel with the given number. It is
+// only permitted while the parcel is ParcelStatusRegistered; once it has
+// moved on, it returns store.ErrAddressImmutable.
+func (s *Store) SetAddress(number int, address string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.parcels[number]
+	if !ok {
+		return store.ErrParcelNotFound
+	}
+	if p.Status != store.ParcelStatusRegistered {
+		return store.ErrAddressImmutable
+	}
+
+	p.Address = address
+	s.parcels[number] = p
+
+	return nil
+}
+
+// SetStatus moves the parcel with the given number to status. The move must
+// be a valid transition from the parcel's current status (see
+// store.ParcelStatusTransition), otherwise it returns
+// store.ErrInvalidStatusTransition.
+func (s *Store) SetStatus(number int, status string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.parcels[number]
+	if !ok {
+		return store.ErrParcelNotFound
+	}
+	if err := store.ParcelStatusTransition(p.Status, status); err != nil {
+		return err
+	}
+
+	p.Status = status
+	s.parcels[number] = p
+
+	return nil
+}
+
+// Delete removes the parcel with the given number. It is only permitted
+// while the parcel is ParcelStatusRegistered, otherwise it returns
+// store.ErrInvalidStatusTransition.
+func (s *Store) Delete(number int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.parcels[number]
+	if !ok {
+		return store.ErrParcelNotFound
+	}
+	if p.Status != store.ParcelStatusRegistered {
+		return store.ErrInvalidStatusTransition
+	}
+
+	delete(s.parcels, number)
+
+	return nil
+}
+
+// GetExpired returns every parcel with the given status whose CreatedAt is
+// older than before.
+func (s *Store) GetExpired(before time.Time, status string) ([]store.Parcel, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var parcels []store.Parcel
+	for _, p := range s.parcels {
+		if p.Status != status {
+			continue
+		}
+
+		createdAt, err := time.Parse(time.RFC3339, p.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		if createdAt.Before(before) {
+			parcels = append(parcels, p)
+		}
+	}
+
+	return parcels, nil
+}
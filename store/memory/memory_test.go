@@ -0,0 +1,15 @@
+package memory_test
+
+import (
+	"testing"
+
+	"go-db-sql-final/store"
+	"go-db-sql-final/store/memory"
+	"go-db-sql-final/store/storetest"
+)
+
+func TestStore(t *testing.T) {
+	storetest.RunConformanceTests(t, func(t *testing.T) store.ParcelStore {
+		return memory.NewStore()
+	})
+}
@@ -0,0 +1,42 @@
+package postgres_test
+
+import (
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go-db-sql-final/store"
+	"go-db-sql-final/store/postgres"
+	"go-db-sql-final/store/storetest"
+)
+
+// TestStore runs the conformance suite against a real PostgreSQL instance.
+// It requires TEST_POSTGRES_DSN to point at a database where the test may
+// freely create and drop the parcel table; it is skipped otherwise since no
+// such database is available in a plain unit-test run.
+func TestStore(t *testing.T) {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN not set, skipping postgres conformance suite")
+	}
+
+	storetest.RunConformanceTests(t, func(t *testing.T) store.ParcelStore {
+		db, err := sql.Open("postgres", dsn)
+		require.NoError(t, err)
+		t.Cleanup(func() { db.Close() })
+
+		_, err = db.Exec(`CREATE TABLE IF NOT EXISTS parcel (
+			number SERIAL PRIMARY KEY,
+			client INTEGER,
+			status TEXT,
+			address TEXT,
+			created_at TEXT
+		)`)
+		require.NoError(t, err)
+		t.Cleanup(func() { db.Exec("DROP TABLE parcel") })
+
+		return postgres.NewStore(db)
+	})
+}
@@ -0,0 +1,188 @@
+// Package sqlite is a SQLite-backed implementation of store.ParcelStore.
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	// the sqlite driver registers itself under the "sqlite" name
+	_ "modernc.org/sqlite"
+
+	"go-db-sql-final/store"
+)
+
+// Store is a SQLite-backed store.ParcelStore.
+type Store struct {
+	db *sql.DB
+}
+
+// NewStore returns a Store that persists parcels into db.
+func NewStore(db *sql.DB) *Store {
+	return &Store{db: db}
+}
+
+// Add inserts a new parcel and returns its assigned number.
+func (s *Store) Add(p store.Parcel) (int, error) {
+	res, err := s.db.Exec(
+		"INSERT INTO parcel (client, status, address, created_at) VALUES (:client, :status, :address, :created_at)",
+		sql.Named("client", p.Client),
+		sql.Named("status", p.Status),
+		sql.Named("address", p.Address),
+		sql.Named("created_at", p.CreatedAt),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(id), nil
+}
+
+// Get returns the parcel with the given number.
+func (s *Store) Get(number int) (store.Parcel, error) {
+	row := s.db.QueryRow(
+		"SELECT number, client, status, address, created_at FROM parcel WHERE number = :number",
+		sql.Named("number", number),
+	)
+
+	var p store.Parcel
+	err := row.Scan(&p.Number, &p.Client, &p.Status, &p.Address, &p.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return store.Parcel{}, store.ErrParcelNotFound
+	}
+	if err != nil {
+		return store.Parcel{}, err
+	}
+
+	return p, nil
+}
+
+// GetByClient returns every parcel belonging to the given client.
+func (s *Store) GetByClient(client int) ([]store.Parcel, error) {
+	rows, err := s.db.Query(
+		"SELECT number, client, status, address, created_at FROM parcel WHERE client = :client",
+		sql.Named("client", client),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var parcels []store.Parcel
+	for rows.Next() {
+		var p store.Parcel
+		if err := rows.Scan(&p.Number, &p.Client, &p.Status, &p.Address, &p.CreatedAt); err != nil {
+			return nil, err
+		}
+		parcels = append(parcels, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return parcels, nil
+}
+
+// SetAddress updates the address of the parcel with the given number. It is
+// only permitted while the parcel is ParcelStatusRegistered; once it has
+// moved on, it returns store.ErrAddressImmutable.
+func (s *Store) SetAddress(number int, address string) error {
+	res, err := s.db.Exec(
+		"UPDATE parcel SET address = :address WHERE number = :number AND status = :status",
+		sql.Named("address", address),
+		sql.Named("number", number),
+		sql.Named("status", store.ParcelStatusRegistered),
+	)
+	if err != nil {
+		return err
+	}
+	return s.checkAffected(res, number, store.ErrAddressImmutable)
+}
+
+// SetStatus moves the parcel with the given number to status. The move must
+// be a valid transition from the parcel's current status (see
+// store.ParcelStatusTransition), otherwise it returns
+// store.ErrInvalidStatusTransition.
+func (s *Store) SetStatus(number int, status string) error {
+	required, ok := store.RequiredStatusFor(status)
+	if !ok {
+		return store.ErrInvalidStatusTransition
+	}
+
+	res, err := s.db.Exec(
+		"UPDATE parcel SET status = :status WHERE number = :number AND status = :required",
+		sql.Named("status", status),
+		sql.Named("number", number),
+		sql.Named("required", required),
+	)
+	if err != nil {
+		return err
+	}
+	return s.checkAffected(res, number, store.ErrInvalidStatusTransition)
+}
+
+// Delete removes the parcel with the given number. It is only permitted
+// while the parcel is ParcelStatusRegistered, otherwise it returns
+// store.ErrInvalidStatusTransition.
+func (s *Store) Delete(number int) error {
+	res, err := s.db.Exec(
+		"DELETE FROM parcel WHERE number = :number AND status = :status",
+		sql.Named("number", number),
+		sql.Named("status", store.ParcelStatusRegistered),
+	)
+	if err != nil {
+		return err
+	}
+	return s.checkAffected(res, number, store.ErrInvalidStatusTransition)
+}
+
+// checkAffected inspects res for a conditional UPDATE/DELETE that matched
+// zero rows, distinguishing "parcel does not exist" from "parcel exists but
+// disallowed" by re-checking the parcel's existence.
+func (s *Store) checkAffected(res sql.Result, number int, disallowedErr error) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected > 0 {
+		return nil
+	}
+
+	if _, err := s.Get(number); errors.Is(err, store.ErrParcelNotFound) {
+		return store.ErrParcelNotFound
+	}
+	return disallowedErr
+}
+
+// GetExpired returns every parcel with the given status whose CreatedAt is
+// older than before.
+func (s *Store) GetExpired(before time.Time, status string) ([]store.Parcel, error) {
+	rows, err := s.db.Query(
+		"SELECT number, client, status, address, created_at FROM parcel WHERE status = :status AND created_at < :before",
+		sql.Named("status", status),
+		sql.Named("before", before.UTC().Format(time.RFC3339)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var parcels []store.Parcel
+	for rows.Next() {
+		var p store.Parcel
+		if err := rows.Scan(&p.Number, &p.Client, &p.Status, &p.Address, &p.CreatedAt); err != nil {
+			return nil, err
+		}
+		parcels = append(parcels, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return parcels, nil
+}
@@ -0,0 +1,17 @@
+package sqlite_test
+
+import (
+	"testing"
+
+	"go-db-sql-final/store"
+	"go-db-sql-final/store/sqlite"
+	"go-db-sql-final/store/storetest"
+	"go-db-sql-final/testhelpers"
+)
+
+func TestStore(t *testing.T) {
+	storetest.RunConformanceTests(t, func(t *testing.T) store.ParcelStore {
+		db, _ := testhelpers.SetupTestDB(t)
+		return sqlite.NewStore(db)
+	})
+}
@@ -0,0 +1,47 @@
+// Package store defines the ParcelStore abstraction shared by every storage
+// backend (sqlite, memory, postgres, ...) as well as the Parcel domain type.
+package store
+
+import "time"
+
+// Parcel statuses. A parcel is always registered first and then moves
+// through the remaining states in order. ParcelStatusExpired is a terminal
+// state reached by the Collector instead of Delete, when the Collector's
+// policy is CollectorPolicyExpire (archive rather than remove stale parcels).
+const (
+	ParcelStatusRegistered = "registered"
+	ParcelStatusSent       = "sent"
+	ParcelStatusDelivered  = "delivered"
+	ParcelStatusExpired    = "expired"
+)
+
+// Parcel represents a single parcel record.
+type Parcel struct {
+	Number    int
+	Client    int
+	Status    string
+	Address   string
+	CreatedAt string
+}
+
+// ParcelStore is implemented by every storage backend (sqlite, memory,
+// postgres, ...). Callers should depend on this interface rather than on a
+// concrete backend so the backend can be swapped without touching business
+// logic.
+type ParcelStore interface {
+	// Add inserts a new parcel and returns its assigned number.
+	Add(p Parcel) (int, error)
+	// Get returns the parcel with the given number.
+	Get(number int) (Parcel, error)
+	// GetByClient returns every parcel belonging to the given client.
+	GetByClient(client int) ([]Parcel, error)
+	// SetAddress updates the address of the parcel with the given number.
+	SetAddress(number int, address string) error
+	// SetStatus updates the status of the parcel with the given number.
+	SetStatus(number int, status string) error
+	// Delete removes the parcel with the given number.
+	Delete(number int) error
+	// GetExpired returns every parcel with the given status whose
+	// CreatedAt is older than before, for use by the Collector.
+	GetExpired(before time.Time, status string) ([]Parcel, error)
+}
@@ -0,0 +1,196 @@
+// Package storetest holds a conformance suite that every store.ParcelStore
+// implementation must pass. Each backend package (sqlite, memory, postgres,
+// ...) runs it against its own store in a regular *_test.go file so the same
+// assertions stay identical across backends.
+package storetest
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go-db-sql-final/store"
+)
+
+var (
+	// randSource - источник псевдослучайных чисел.
+	// Для повышения уникальности в качестве seed используется текущее время в unix формате (в виде числа)
+	randSource = rand.NewSource(time.Now().UnixNano())
+	// randRange использует randSource для генерации случайных чисел
+	randRange = rand.New(randSource)
+)
+
+// getTestParcel возвращает тестовую посылку
+func getTestParcel() store.Parcel {
+	return store.Parcel{
+		Client:    1000,
+		Status:    store.ParcelStatusRegistered,
+		Address:   "test",
+		CreatedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+}
+
+// RunConformanceTests runs the full store.ParcelStore conformance suite
+// against a freshly created store returned by newStore. newStore is called
+// once per subtest so backends that keep state in a shared database can
+// reset it between runs.
+func RunConformanceTests(t *testing.T, newStore func(t *testing.T) store.ParcelStore) {
+	t.Run("AddGetDelete", func(t *testing.T) {
+		testAddGetDelete(t, newStore(t))
+	})
+	t.Run("SetAddress", func(t *testing.T) {
+		testSetAddress(t, newStore(t))
+	})
+	t.Run("SetStatus", func(t *testing.T) {
+		testSetStatus(t, newStore(t))
+	})
+	t.Run("GetByClient", func(t *testing.T) {
+		testGetByClient(t, newStore(t))
+	})
+	t.Run("ForbiddenTransitions", func(t *testing.T) {
+		testForbiddenTransitions(t, newStore(t))
+	})
+}
+
+// testAddGetDelete проверяет добавление, получение и удаление посылки
+func testAddGetDelete(t *testing.T, s store.ParcelStore) {
+	parcel := getTestParcel()
+
+	// add
+	// добавляем посылку и получаем её номер
+	number, err := s.Add(parcel)
+	require.NoError(t, err)
+	parcel.Number = number
+
+	// get
+	// получаем только что добавленную посылку по номеру
+	storedParcel, err := s.Get(parcel.Number)
+	require.NoError(t, err)
+	require.Equal(t, parcel, storedParcel)
+
+	// delete
+	// удаляем посылку
+	err = s.Delete(parcel.Number)
+	require.NoError(t, err)
+
+	// проверяем, что посылка удалена
+	_, err = s.Get(parcel.Number)
+	require.ErrorIs(t, err, store.ErrParcelNotFound)
+}
+
+// testSetAddress проверяет обновление адреса посылки
+func testSetAddress(t *testing.T, s store.ParcelStore) {
+	parcel := getTestParcel()
+
+	// добавляем посылку и получаем её номер
+	number, err := s.Add(parcel)
+	require.NoError(t, err)
+	parcel.Number = number
+
+	// set address
+	// изменяем адрес посылки
+	newAddress := "new test address"
+	err = s.SetAddress(parcel.Number, newAddress)
+	require.NoError(t, err)
+
+	// check
+	// проверяем, что адрес посылки обновился
+	storedParcel, err := s.Get(parcel.Number)
+	require.NoError(t, err)
+	require.Equal(t, newAddress, storedParcel.Address)
+}
+
+// testSetStatus проверяет обновление статуса посылки
+func testSetStatus(t *testing.T, s store.ParcelStore) {
+	parcel := getTestParcel()
+
+	// добавляем посылку и получаем её номер
+	number, err := s.Add(parcel)
+	require.NoError(t, err)
+	parcel.Number = number
+
+	// set status
+	// изменяем статус посылки на "sent"
+	err = s.SetStatus(parcel.Number, store.ParcelStatusSent)
+	require.NoError(t, err)
+
+	// check
+	// проверяем, что статус посылки обновился
+	storedParcel, err := s.Get(parcel.Number)
+	require.NoError(t, err)
+	require.Equal(t, store.ParcelStatusSent, storedParcel.Status)
+}
+
+// testGetByClient проверяет получение посылок по идентификатору клиента
+func testGetByClient(t *testing.T, s store.ParcelStore) {
+	parcels := []store.Parcel{
+		getTestParcel(),
+		getTestParcel(),
+		getTestParcel(),
+	}
+	client := randRange.Intn(10_000_000)
+	for i := range parcels {
+		parcels[i].Client = client
+		number, err := s.Add(parcels[i])
+		require.NoError(t, err)
+		parcels[i].Number = number
+	}
+
+	// get by client
+	// получаем все посылки для данного клиента
+	storedParcels, err := s.GetByClient(client)
+	require.NoError(t, err)
+
+	// check all parcels for the client
+	// проверяем, что количество полученных посылок совпадает с количеством добавленных
+	require.Len(t, storedParcels, len(parcels))
+
+	// проверяем, что все посылки из storedParcels присутствуют в оригинальном списке
+	for _, storedParcel := range storedParcels {
+		require.Contains(t, parcels, storedParcel) // проверка наличия посылки в исходных данных
+	}
+}
+
+// testForbiddenTransitions проверяет, что запрещённые переходы статуса,
+// изменение адреса и удаление возвращают соответствующие типизированные
+// ошибки.
+func testForbiddenTransitions(t *testing.T, s store.ParcelStore) {
+	parcel := getTestParcel()
+	number, err := s.Add(parcel)
+	require.NoError(t, err)
+
+	// нельзя перевести посылку сразу в "delivered", минуя "sent"
+	err = s.SetStatus(number, store.ParcelStatusDelivered)
+	require.ErrorIs(t, err, store.ErrInvalidStatusTransition)
+
+	err = s.SetStatus(number, store.ParcelStatusSent)
+	require.NoError(t, err)
+
+	// адрес можно менять только пока посылка "registered"
+	err = s.SetAddress(number, "new address")
+	require.ErrorIs(t, err, store.ErrAddressImmutable)
+
+	// удалить можно только "registered" посылку
+	err = s.Delete(number)
+	require.ErrorIs(t, err, store.ErrInvalidStatusTransition)
+
+	// повторный переход "sent" -> "sent" тоже запрещён
+	err = s.SetStatus(number, store.ParcelStatusSent)
+	require.ErrorIs(t, err, store.ErrInvalidStatusTransition)
+
+	// операции с несуществующей посылкой возвращают ErrParcelNotFound
+	const missing = -1
+	_, err = s.Get(missing)
+	require.ErrorIs(t, err, store.ErrParcelNotFound)
+
+	err = s.SetStatus(missing, store.ParcelStatusSent)
+	require.ErrorIs(t, err, store.ErrParcelNotFound)
+
+	err = s.SetAddress(missing, "new address")
+	require.ErrorIs(t, err, store.ErrParcelNotFound)
+
+	err = s.Delete(missing)
+	require.ErrorIs(t, err, store.ErrParcelNotFound)
+}
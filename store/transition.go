@@ -0,0 +1,28 @@
+package store
+
+// requiredStatusFor maps a target status to the status a parcel must
+// currently be in to move there.
+var requiredStatusFor = map[string]string{
+	ParcelStatusSent:      ParcelStatusRegistered,
+	ParcelStatusDelivered: ParcelStatusSent,
+	ParcelStatusExpired:   ParcelStatusRegistered,
+}
+
+// ParcelStatusTransition reports whether a parcel may move from status from
+// to status to, without needing a round-trip to the store. It returns
+// ErrInvalidStatusTransition if the move is not allowed.
+func ParcelStatusTransition(from, to string) error {
+	required, ok := RequiredStatusFor(to)
+	if !ok || required != from {
+		return ErrInvalidStatusTransition
+	}
+	return nil
+}
+
+// RequiredStatusFor returns the status a parcel must currently be in to
+// move to the given target status, and whether to is a reachable status at
+// all.
+func RequiredStatusFor(to string) (string, bool) {
+	required, ok := requiredStatusFor[to]
+	return required, ok
+}
@@ -0,0 +1,19 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go-db-sql-final/store"
+)
+
+func TestParcelStatusTransition(t *testing.T) {
+	require.NoError(t, store.ParcelStatusTransition(store.ParcelStatusRegistered, store.ParcelStatusSent))
+	require.NoError(t, store.ParcelStatusTransition(store.ParcelStatusSent, store.ParcelStatusDelivered))
+	require.NoError(t, store.ParcelStatusTransition(store.ParcelStatusRegistered, store.ParcelStatusExpired))
+
+	require.ErrorIs(t, store.ParcelStatusTransition(store.ParcelStatusRegistered, store.ParcelStatusDelivered), store.ErrInvalidStatusTransition)
+	require.ErrorIs(t, store.ParcelStatusTransition(store.ParcelStatusSent, store.ParcelStatusSent), store.ErrInvalidStatusTransition)
+	require.ErrorIs(t, store.ParcelStatusTransition(store.ParcelStatusDelivered, store.ParcelStatusSent), store.ErrInvalidStatusTransition)
+}
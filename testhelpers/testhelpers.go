@@ -0,0 +1,32 @@
+// Package testhelpers provides shared test setup used across the store
+// backend test suites.
+package testhelpers
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	// the sqlite driver registers itself under the "sqlite" name
+	_ "modernc.org/sqlite"
+
+	"go-db-sql-final/migrate"
+)
+
+// SetupTestDB opens an in-memory SQLite database, migrates it to HEAD, and
+// returns it along with a cleanup func that closes it. The cleanup is also
+// registered with t.Cleanup, so callers may discard the returned func.
+func SetupTestDB(t *testing.T) (*sql.DB, func()) {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+
+	require.NoError(t, migrate.Migrate(db))
+
+	cleanup := func() { db.Close() }
+	t.Cleanup(cleanup)
+
+	return db, cleanup
+}